@@ -0,0 +1,110 @@
+package v1alpha1
+
+// ReplicaSchedulingStrategy represents the strategy used for scheduling replicas when a
+// PropagationPolicy or ClusterPropagationPolicy propagates a resource to multiple clusters.
+type ReplicaSchedulingStrategy struct {
+	// ReplicaSchedulingType determines how the replicas is scheduled when Karmada propagating a
+	// resource. Valid options are Duplicated and Divided.
+	// "Duplicated" means, for each resource templates, Karmada will create the same number of
+	// replicas in each matching cluster.
+	// "Divided" means, for each resource templates, Karmada will divide the total number of
+	// replicas across clusters according to ReplicaDivisionPreference.
+	// +kubebuilder:validation:Enum=Duplicated;Divided
+	// +optional
+	ReplicaSchedulingType ReplicaSchedulingType `json:"replicaSchedulingType,omitempty"`
+
+	// ReplicaDivisionPreference determines how the replicas is divided when ReplicaSchedulingType
+	// is "Divided". Valid options are Aggregated, Weighted and Sticky. Ignored when
+	// ReplicaSchedulingType is "Duplicated".
+	// +kubebuilder:validation:Enum=Aggregated;Weighted;Sticky
+	// +optional
+	ReplicaDivisionPreference ReplicaDivisionPreference `json:"replicaDivisionPreference,omitempty"`
+
+	// WeightPreference describes weight for each cluster or for each group of cluster.
+	// Only valid when ReplicaDivisionPreference is "Weighted", where it selects the weight that
+	// divides the replicas. "Sticky" does not read it: the sticky rebalancer always weights clusters
+	// by their available replicas.
+	// +optional
+	WeightPreference *ClusterPreferences `json:"weightPreference,omitempty"`
+}
+
+// ReplicaSchedulingType describes scheduling strategies for replicas.
+type ReplicaSchedulingType string
+
+const (
+	// ReplicaSchedulingTypeDuplicated means when scheduling resource templates to multiple
+	// clusters, each resource template has the same number of replicas in every matching cluster.
+	ReplicaSchedulingTypeDuplicated ReplicaSchedulingType = "Duplicated"
+	// ReplicaSchedulingTypeDivided means when scheduling resource templates to multiple clusters,
+	// the total number of replicas is divided across clusters.
+	ReplicaSchedulingTypeDivided ReplicaSchedulingType = "Divided"
+)
+
+// ReplicaDivisionPreference describes options to divide replicas among clusters.
+type ReplicaDivisionPreference string
+
+const (
+	// ReplicaDivisionPreferenceAggregated divides replicas into clusters as few as possible,
+	// while respecting each cluster's resource availability, to minimize the number of clusters
+	// a resource template spreads across.
+	ReplicaDivisionPreferenceAggregated ReplicaDivisionPreference = "Aggregated"
+	// ReplicaDivisionPreferenceWeighted divides replicas by weight according to
+	// WeightPreference.
+	ReplicaDivisionPreferenceWeighted ReplicaDivisionPreference = "Weighted"
+	// ReplicaDivisionPreferenceSticky divides replicas in proportion to each cluster's available
+	// replicas (WeightPreference is not consulted), and minimizes the number of replicas that move
+	// relative to the prior placement instead of redividing them from scratch on every reschedule.
+	ReplicaDivisionPreferenceSticky ReplicaDivisionPreference = "Sticky"
+)
+
+// ClusterPreferences describes weight for clusters.
+type ClusterPreferences struct {
+	// StaticWeightList is a list of StaticClusterWeight, each assigning a static weight to the
+	// clusters matching its TargetCluster.
+	// +optional
+	StaticWeightList []StaticClusterWeight `json:"staticWeightList,omitempty"`
+
+	// DynamicWeight specifies the factor to generate dynamic weight list. If specified, the
+	// StaticWeightList will be ignored.
+	// +kubebuilder:validation:Enum=AvailableReplicas;DynamicWeightByDRF
+	// +optional
+	DynamicWeight DynamicWeightFactor `json:"dynamicWeight,omitempty"`
+}
+
+// StaticClusterWeight defines the static cluster weight.
+type StaticClusterWeight struct {
+	// TargetCluster describes the filter to select clusters.
+	// +optional
+	TargetCluster ClusterAffinity `json:"targetCluster,omitempty"`
+
+	// Weight expressing the preference to the cluster(s) specified by TargetCluster.
+	// +kubebuilder:validation:Minimum=1
+	Weight int64 `json:"weight"`
+}
+
+// DynamicWeightFactor represents the resource factor used to generate a dynamic weight list for
+// scheduling replicas, as an alternative to a fixed StaticWeightList.
+type DynamicWeightFactor string
+
+const (
+	// DynamicWeightByAvailableReplicas represents the cluster's available replicas, as calculated
+	// by calAvailableReplicas, should be used as the dynamic weight factor.
+	DynamicWeightByAvailableReplicas DynamicWeightFactor = "AvailableReplicas"
+	// DynamicWeightByDRF represents that the dynamic weight should be computed via Dominant
+	// Resource Fairness: a cluster's weight is its available replicas scaled down by its dominant
+	// share, the highest used/capacity ratio among the resources the binding actually requests, so
+	// a cluster nearly out of any requested resource gets a small weight.
+	DynamicWeightByDRF DynamicWeightFactor = "DynamicWeightByDRF"
+)
+
+// ClusterAffinity represents the filter to select clusters.
+type ClusterAffinity struct {
+	// LabelSelector is a filter to select member clusters by labels. If non-nil and non-empty,
+	// only the clusters matching this filter will be selected.
+	// +optional
+	LabelSelector map[string]string `json:"labelSelector,omitempty"`
+
+	// ClusterNames is the list of clusters to be selected.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty"`
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// StrategyType represents the replica dividing strategy that dynamicDivideReplicas is going to apply.
+type StrategyType string
+
+const (
+	// AggregatedStrategy means dividing replicas aggregately, which will prefer fewer clusters with higher priority.
+	AggregatedStrategy StrategyType = "Aggregated"
+	// DynamicWeightStrategy means dividing replicas by the dynamic weight of the candidate clusters, such as
+	// their available replicas.
+	DynamicWeightStrategy StrategyType = "DynamicWeight"
+	// StickyStrategy means dividing replicas by weight, same as DynamicWeightStrategy, but minimizing the
+	// number of replicas that move relative to the previous placement instead of recomputing it from scratch.
+	StickyStrategy StrategyType = "Sticky"
+)
+
+// assignState is a wrapper of the context for the assigning process, it is used to avoid passing the same
+// arguments among the divide functions again and again.
+type assignState struct {
+	candidates []*clusterv1alpha1.Cluster
+	spec       *workv1alpha2.ResourceBindingSpec
+
+	// bindingNamespace and bindingName identify the ResourceBinding being scheduled. They carry no
+	// scheduling semantics of their own; they only seed the hash-based tiebreak (see
+	// clusterBindingHash) used to deterministically, but non-uniformly, break ties between clusters
+	// that are otherwise equally weighted.
+	bindingNamespace string
+	bindingName      string
+
+	strategy            *policyv1alpha1.ReplicaSchedulingStrategy
+	strategyType        StrategyType
+	dynamicWeightFactor policyv1alpha1.DynamicWeightFactor
+
+	scheduledClusters []workv1alpha2.TargetCluster
+	assignedReplicas  int32
+	targetReplicas    int32
+
+	availableClusters []workv1alpha2.TargetCluster
+	availableReplicas int32
+}
+
+func newAssignState(candidates []*clusterv1alpha1.Cluster, strategy *policyv1alpha1.ReplicaSchedulingStrategy, spec *workv1alpha2.ResourceBindingSpec, bindingNamespace, bindingName string) *assignState {
+	var strategyType StrategyType
+	switch {
+	case strategy == nil:
+		strategyType = DynamicWeightStrategy
+	case strategy.ReplicaDivisionPreference == policyv1alpha1.ReplicaDivisionPreferenceAggregated:
+		strategyType = AggregatedStrategy
+	case strategy.ReplicaDivisionPreference == policyv1alpha1.ReplicaDivisionPreferenceSticky:
+		strategyType = StickyStrategy
+	default:
+		strategyType = DynamicWeightStrategy
+	}
+
+	var dynamicWeightFactor policyv1alpha1.DynamicWeightFactor
+	if strategy != nil && strategy.WeightPreference != nil {
+		dynamicWeightFactor = strategy.WeightPreference.DynamicWeight
+	}
+
+	return &assignState{
+		candidates:          candidates,
+		spec:                spec,
+		bindingNamespace:    bindingNamespace,
+		bindingName:         bindingName,
+		strategy:            strategy,
+		strategyType:        strategyType,
+		dynamicWeightFactor: dynamicWeightFactor,
+	}
+}
+
+// buildAvailableClusters builds the availableClusters and availableReplicas by calling the given calculator
+// against the candidate clusters.
+func (as *assignState) buildAvailableClusters(calculator func([]*clusterv1alpha1.Cluster, *workv1alpha2.ResourceBindingSpec) []workv1alpha2.TargetCluster) {
+	as.availableClusters = calculator(as.candidates, as.spec)
+	as.availableReplicas = util.GetSumOfReplicas(as.availableClusters)
+}
+
+// resortAvailableClusters is used for aggregated scheduling and returns the clusters sorted by available replicas
+// in descending order, so that the caller can keep picking up the top cluster(s) until the target replicas are met.
+func (as *assignState) resortAvailableClusters() TargetClustersList {
+	clusters := make(TargetClustersList, len(as.availableClusters))
+	copy(clusters, as.availableClusters)
+	sortTargetClusters(clusters, as.bindingNamespace, as.bindingName)
+	return clusters
+}
+
+// calAvailableReplicas calculates the available replicas per cluster by asking every registered
+// ClusterResourceProvider (see resource_provider.go) and taking the minimum of their answers, so any
+// provider - the default allocatable-based one or an operator-supplied real-time pressure signal - can
+// clamp a cluster's availability down.
+func calAvailableReplicas(clusters []*clusterv1alpha1.Cluster, spec *workv1alpha2.ResourceBindingSpec) []workv1alpha2.TargetCluster {
+	availableTargetClusters := make([]workv1alpha2.TargetCluster, len(clusters))
+	for i, cluster := range clusters {
+		availableTargetClusters[i].Name = cluster.Name
+		availableTargetClusters[i].Replicas = minAvailableReplicas(cluster, spec.ReplicaRequirements)
+	}
+	return availableTargetClusters
+}
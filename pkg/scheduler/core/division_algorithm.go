@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
@@ -18,9 +19,26 @@ func (a TargetClustersList) Len() int           { return len(a) }
 func (a TargetClustersList) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a TargetClustersList) Less(i, j int) bool { return a[i].Replicas > a[j].Replicas }
 
+// sortTargetClusters sorts clusters by replicas in descending order, same as TargetClustersList.Less,
+// but breaks ties between equally-sized clusters with the binding-scoped hash instead of leaving them
+// in whatever order they arrived in. See clusterBindingHash for why this matters.
+func sortTargetClusters(list TargetClustersList, bindingNamespace, bindingName string) {
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Replicas != list[j].Replicas {
+			return list[i].Replicas > list[j].Replicas
+		}
+		return clusterBindingHash(list[i].Name, bindingNamespace, bindingName) < clusterBindingHash(list[j].Name, bindingNamespace, bindingName)
+	})
+}
+
 type dispenser struct {
 	numReplicas int32
 	result      []workv1alpha2.TargetCluster
+
+	// bindingNamespace and bindingName are threaded in from assignState purely to seed the
+	// leftover-replica tiebreak in takeByWeight; see clusterBindingHash.
+	bindingNamespace string
+	bindingName      string
 }
 
 func newDispenser(numReplicas int32, init []workv1alpha2.TargetCluster) *dispenser {
@@ -44,22 +62,28 @@ func (a *dispenser) takeByWeight(w helper.ClusterWeightInfoList) {
 
 	sort.Sort(w)
 
+	// Compute the base allocation for every cluster by integer floor division, and keep track of the
+	// fractional remainder (still scaled by sum) so that the leftover replicas can be handed out by
+	// largest-remainder (Hare quota) apportionment instead of biasing towards the heaviest clusters.
 	result := make([]workv1alpha2.TargetCluster, 0, w.Len())
+	remainders := make([]int64, w.Len())
 	remain := a.numReplicas
-	for _, info := range w {
-		replicas := int32(info.Weight * int64(a.numReplicas) / sum)
+	for i, info := range w {
+		product := info.Weight * int64(a.numReplicas)
+		replicas := int32(product / sum)
 		result = append(result, workv1alpha2.TargetCluster{
 			Name:     info.ClusterName,
 			Replicas: replicas,
 		})
+		remainders[i] = product - int64(replicas)*sum
 		remain -= replicas
 	}
-	// TODO(Garrybest): take rest replicas by fraction part
-	for i := range result {
+
+	for _, idx := range a.largestRemainderOrder(result, remainders) {
 		if remain == 0 {
 			break
 		}
-		result[i].Replicas++
+		result[idx].Replicas++
 		remain--
 	}
 
@@ -67,6 +91,81 @@ func (a *dispenser) takeByWeight(w helper.ClusterWeightInfoList) {
 	a.result = util.MergeTargetClusters(a.result, result)
 }
 
+// largestRemainderOrder returns the indices of result sorted from the largest remainder to the
+// smallest, so that leftover replicas can be dispensed one-by-one to the clusters that are owed the
+// most by largest-remainder (Hare quota) apportionment. Clusters with an equal remainder are broken
+// deterministically by the binding-scoped cluster hash rather than by their arrival order, so that
+// equally-weighted clusters don't always lose the leftover replica to whichever one sorts first.
+func (a *dispenser) largestRemainderOrder(result []workv1alpha2.TargetCluster, remainders []int64) []int {
+	order := make([]int, len(remainders))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if remainders[order[i]] != remainders[order[j]] {
+			return remainders[order[i]] > remainders[order[j]]
+		}
+		return clusterBindingHash(result[order[i]].Name, a.bindingNamespace, a.bindingName) < clusterBindingHash(result[order[j]].Name, a.bindingNamespace, a.bindingName)
+	})
+	return order
+}
+
+// clampToAvailable caps every cluster's dispensed replicas at its real capacity and reassigns any
+// overflow to clusters that still have headroom, preferring the one with the most headroom left and
+// breaking ties deterministically with the binding-scoped hash.
+//
+// result is disp.result: state.scheduledClusters (replicas already running) merged with the newly
+// dispensed delta. availableClusters, however, is only the *additional* capacity calAvailableReplicas
+// computed for each cluster on top of what's already scheduled there - so the real per-cluster cap is
+// scheduled_i + available_i, not available_i alone; capping at available_i alone would strip away
+// already-running replicas on any scale-up with a non-empty prior placement.
+// It assumes total(scheduled) + total(availableClusters) >= total(result), which
+// dynamicDivideReplicas already guarantees via its availableReplicas precheck.
+func clampToAvailable(result []workv1alpha2.TargetCluster, availableClusters []workv1alpha2.TargetCluster, scheduledClusters []workv1alpha2.TargetCluster, bindingNamespace, bindingName string) []workv1alpha2.TargetCluster {
+	limit := make(map[string]int32, len(availableClusters))
+	for _, tc := range availableClusters {
+		limit[tc.Name] += tc.Replicas
+	}
+	for _, tc := range scheduledClusters {
+		limit[tc.Name] += tc.Replicas
+	}
+
+	var overflow int32
+	for i := range result {
+		if allowed := limit[result[i].Name]; result[i].Replicas > allowed {
+			overflow += result[i].Replicas - allowed
+			result[i].Replicas = allowed
+		}
+	}
+	for overflow > 0 {
+		idx := mostHeadroomIndex(result, limit, bindingNamespace, bindingName)
+		if idx < 0 {
+			break
+		}
+		result[idx].Replicas++
+		overflow--
+	}
+	return result
+}
+
+// mostHeadroomIndex returns the index of the result entry with the most remaining headroom
+// (available - dispensed), or -1 if every cluster is already at capacity.
+func mostHeadroomIndex(result []workv1alpha2.TargetCluster, available map[string]int32, bindingNamespace, bindingName string) int {
+	best := -1
+	var bestHeadroom int32
+	for i := range result {
+		headroom := available[result[i].Name] - result[i].Replicas
+		if headroom <= 0 {
+			continue
+		}
+		if best == -1 || headroom > bestHeadroom ||
+			(headroom == bestHeadroom && clusterBindingHash(result[i].Name, bindingNamespace, bindingName) < clusterBindingHash(result[best].Name, bindingNamespace, bindingName)) {
+			best, bestHeadroom = i, headroom
+		}
+	}
+	return best
+}
+
 func getStaticWeightInfoList(clusters []*clusterv1alpha1.Cluster, weightList []policyv1alpha1.StaticClusterWeight) helper.ClusterWeightInfoList {
 	list := make(helper.ClusterWeightInfoList, 0)
 	for _, cluster := range clusters {
@@ -125,16 +224,139 @@ func dynamicDivideReplicas(state *assignState) ([]workv1alpha2.TargetCluster, er
 	case DynamicWeightStrategy:
 		// Set the availableClusters as the weight, scheduledClusters as init result, target as the dispenser object.
 		// After dispensing, the target cluster will be the combination of init result and weighted result for target replicas.
-		weightList := getStaticWeightInfoListByTargetClusters(state.availableClusters)
+		var weightList helper.ClusterWeightInfoList
+		if state.dynamicWeightFactor == policyv1alpha1.DynamicWeightByDRF {
+			weightList = getDRFWeightInfoList(state.candidates, state.availableClusters, state.spec.ReplicaRequirements)
+		} else {
+			weightList = getStaticWeightInfoListByTargetClusters(state.availableClusters)
+		}
 		disp := newDispenser(state.targetReplicas, state.scheduledClusters)
+		disp.bindingNamespace, disp.bindingName = state.bindingNamespace, state.bindingName
 		disp.takeByWeight(weightList)
-		return disp.result, nil
+		result := disp.result
+		if state.dynamicWeightFactor == policyv1alpha1.DynamicWeightByDRF {
+			// Unlike getStaticWeightInfoListByTargetClusters, whose weight *is* the cluster's
+			// available replicas, the DRF weight is a fairness score decoupled from real capacity.
+			// Clamp the dispensed result back down to what each cluster can actually host, moving
+			// any overflow to clusters that still have headroom.
+			result = clampToAvailable(result, state.availableClusters, state.scheduledClusters, state.bindingNamespace, state.bindingName)
+		}
+		return result, nil
+	case StickyStrategy:
+		return stickyDivideReplicas(state)
 	default:
 		// should never happen
 		return nil, fmt.Errorf("undefined strategy type: %s", state.strategyType)
 	}
 }
 
+// stickyDivideReplicas assigns replicas to the available clusters so that the result is balanced with
+// respect to their weight while minimizing the number of replicas that move relative to
+// state.scheduledClusters, modeled on Sarama's sticky partition assignor: start from the prior
+// placement, compute each cluster's surplus/deficit against its weighted target, and greedily move
+// replicas from the most-surplus cluster to the most-deficient one until no move would improve
+// balance by more than one replica.
+func stickyDivideReplicas(state *assignState) ([]workv1alpha2.TargetCluster, error) {
+	weightList := getStaticWeightInfoListByTargetClusters(state.availableClusters)
+	sum := weightList.GetWeightSum()
+	if sum == 0 {
+		return nil, fmt.Errorf("no available clusters to schedule")
+	}
+
+	// With a prior placement, targetReplicas only counts the replicas to add on top of the
+	// assignedReplicas already placed (see dynamicScaleUp); the rebalance below works on the total.
+	totalReplicas := state.targetReplicas
+	if len(state.scheduledClusters) > 0 {
+		totalReplicas += state.assignedReplicas
+	}
+
+	names := make([]string, 0, len(weightList))
+	target := make(map[string]int32, len(weightList))
+	for _, info := range weightList {
+		names = append(names, info.ClusterName)
+		target[info.ClusterName] = int32(math.Round(float64(info.Weight) * float64(totalReplicas) / float64(sum)))
+	}
+	// Order deterministically so that ties in the surplus/deficit search below resolve the same way
+	// on every reschedule of the same binding, rather than depending on map iteration order.
+	sort.SliceStable(names, func(i, j int) bool {
+		return clusterBindingHash(names[i], state.bindingNamespace, state.bindingName) < clusterBindingHash(names[j], state.bindingNamespace, state.bindingName)
+	})
+
+	current := make(map[string]int32, len(names))
+	var assigned int32
+	for _, tc := range state.scheduledClusters {
+		if _, ok := target[tc.Name]; !ok {
+			// This cluster is no longer available; its replicas fall out of the placement and get
+			// reassigned below, like any other deficit.
+			continue
+		}
+		current[tc.Name] += tc.Replicas
+		assigned += tc.Replicas
+	}
+
+	// Bring the total up (or down) to totalReplicas by adding/removing replicas one at a time on
+	// whichever cluster is furthest below (or above) its weighted target. This never touches a
+	// cluster that is already at or above its target while there's a more deficient one available.
+	for assigned < totalReplicas {
+		name := mostDeficientCluster(names, current, target)
+		current[name]++
+		assigned++
+	}
+	for assigned > totalReplicas {
+		name := mostSurplusCluster(names, current, target)
+		if current[name] == 0 {
+			break
+		}
+		current[name]--
+		assigned--
+	}
+
+	// Now that the total matches, keep moving a single replica from the most-surplus cluster to the
+	// most-deficient one as long as doing so improves balance; this is what lets scaling keep the
+	// bulk of the previous placement untouched instead of recomputing it from scratch.
+	for {
+		from := mostSurplusCluster(names, current, target)
+		to := mostDeficientCluster(names, current, target)
+		surplus, deficit := current[from]-target[from], target[to]-current[to]
+		if from == to || surplus <= 0 || deficit <= 0 {
+			break
+		}
+		current[from]--
+		current[to]++
+	}
+
+	result := make([]workv1alpha2.TargetCluster, 0, len(names))
+	for _, name := range names {
+		if current[name] == 0 {
+			continue
+		}
+		result = append(result, workv1alpha2.TargetCluster{Name: name, Replicas: current[name]})
+	}
+	return result, nil
+}
+
+func mostDeficientCluster(names []string, current, target map[string]int32) string {
+	best := names[0]
+	bestDeficit := target[best] - current[best]
+	for _, name := range names[1:] {
+		if deficit := target[name] - current[name]; deficit > bestDeficit {
+			best, bestDeficit = name, deficit
+		}
+	}
+	return best
+}
+
+func mostSurplusCluster(names []string, current, target map[string]int32) string {
+	best := names[0]
+	bestSurplus := current[best] - target[best]
+	for _, name := range names[1:] {
+		if surplus := current[name] - target[name]; surplus > bestSurplus {
+			best, bestSurplus = name, surplus
+		}
+	}
+	return best
+}
+
 func dynamicScaleDown(state *assignState) ([]workv1alpha2.TargetCluster, error) {
 	// The previous scheduling result will be the weight reference of scaling down.
 	// In other words, we scale down the replicas proportionally by their scheduled replicas.
@@ -147,7 +369,7 @@ func dynamicScaleDown(state *assignState) ([]workv1alpha2.TargetCluster, error)
 	state.buildAvailableClusters(func(_ []*clusterv1alpha1.Cluster, spec *workv1alpha2.ResourceBindingSpec) []workv1alpha2.TargetCluster {
 		availableClusters := make(TargetClustersList, len(spec.Clusters))
 		copy(availableClusters, spec.Clusters)
-		sort.Sort(availableClusters)
+		sortTargetClusters(availableClusters, state.bindingNamespace, state.bindingName)
 		return availableClusters
 	})
 	return dynamicDivideReplicas(state)
@@ -158,7 +380,7 @@ func dynamicScaleUp(state *assignState) ([]workv1alpha2.TargetCluster, error) {
 	state.targetReplicas = state.spec.Replicas - state.assignedReplicas
 	state.buildAvailableClusters(func(clusters []*clusterv1alpha1.Cluster, spec *workv1alpha2.ResourceBindingSpec) []workv1alpha2.TargetCluster {
 		clusterAvailableReplicas := calAvailableReplicas(clusters, spec)
-		sort.Sort(TargetClustersList(clusterAvailableReplicas))
+		sortTargetClusters(clusterAvailableReplicas, state.bindingNamespace, state.bindingName)
 		return clusterAvailableReplicas
 	})
 	return dynamicDivideReplicas(state)
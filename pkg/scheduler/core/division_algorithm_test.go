@@ -1,6 +1,7 @@
 package core
 
 import (
+	"sort"
 	"testing"
 
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
@@ -84,6 +85,49 @@ func Test_dispenser_takeByWeight(t *testing.T) {
 			},
 			done: true,
 		},
+		{
+			// Equal weights with a remainder: 4*1/3 floors to a base allocation of 1 replica per
+			// cluster (3 assigned), leaving exactly 1 leftover. The old floor-then-walk-the-sorted-list
+			// behavior always handed that leftover to whichever cluster sorted first; with equal
+			// remainders here it's still a tie, broken deterministically by clusterBindingHash rather
+			// than by arrival order. With the empty binding ns/name used here that hashes "A/", "B/"
+			// and "C/" to 1349974741, 1333197128 and 1316419579 respectively, so the smallest hash -
+			// and the leftover - lands on C.
+			name:        "Equal weight 1:1:1, 4 replicas",
+			numReplicas: 4,
+			result:      []workv1alpha2.TargetCluster{},
+			weightList: []utilhelper.ClusterWeightInfo{
+				{ClusterName: "A", Weight: 1},
+				{ClusterName: "B", Weight: 1},
+				{ClusterName: "C", Weight: 1},
+			},
+			desired: []workv1alpha2.TargetCluster{
+				{Name: "A", Replicas: 1},
+				{Name: "B", Replicas: 1},
+				{Name: "C", Replicas: 2},
+			},
+			done: true,
+		},
+		{
+			// weight 20:12:6 over 12 replicas: floor quotas are 6/3/1 (10 allocated, 2 left over).
+			// Remainders scaled by the weight sum of 38 are 12/30/34, so largest-remainder hands the
+			// two leftovers to member C, then B, rather than piling both onto the heaviest cluster, A,
+			// which is what the old floor-then-walk-the-sorted-list code did.
+			name:        "Weight 20:12:6, 12 replicas",
+			numReplicas: 12,
+			result:      []workv1alpha2.TargetCluster{},
+			weightList: []utilhelper.ClusterWeightInfo{
+				{ClusterName: "A", Weight: 20},
+				{ClusterName: "B", Weight: 12},
+				{ClusterName: "C", Weight: 6},
+			},
+			desired: []workv1alpha2.TargetCluster{
+				{Name: "A", Replicas: 6},
+				{Name: "B", Replicas: 4},
+				{Name: "C", Replicas: 2},
+			},
+			done: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -126,6 +170,9 @@ func Test_dynamicDivideReplicas(t *testing.T) {
 			wantErr: false,
 		},
 		{
+			// Quotas are 6.31/3.78/1.89 (floors 6/3/1), with remainders (scaled by the weight sum of 38) of
+			// 12/30/34. Largest-remainder apportionment hands the two leftover replicas to member3
+			// and member2 instead of piling both onto the heaviest cluster, member1.
 			name: "replica 12, dynamic weight 20:12:6",
 			state: &assignState{
 				availableClusters: TargetClustersList{
@@ -138,9 +185,9 @@ func Test_dynamicDivideReplicas(t *testing.T) {
 				strategyType:      DynamicWeightStrategy,
 			},
 			want: []workv1alpha2.TargetCluster{
-				{Name: ClusterMember1, Replicas: 7},
+				{Name: ClusterMember1, Replicas: 6},
 				{Name: ClusterMember2, Replicas: 4},
-				{Name: ClusterMember3, Replicas: 1},
+				{Name: ClusterMember3, Replicas: 2},
 			},
 			wantErr: false,
 		},
@@ -212,3 +259,198 @@ func Test_dynamicDivideReplicas(t *testing.T) {
 		})
 	}
 }
+
+// Test_dispenser_takeByWeight_hashTiebreak asserts that, when clusters are tied on weight, a given
+// binding's single leftover replica always lands on the same cluster no matter how many times it is
+// rescheduled. Whether a different binding lands on a different cluster is only logged, since two
+// bindings can hash to the same cluster.
+func Test_dispenser_takeByWeight_hashTiebreak(t *testing.T) {
+	weightList := utilhelper.ClusterWeightInfoList{
+		{ClusterName: ClusterMember1, Weight: 1},
+		{ClusterName: ClusterMember2, Weight: 1},
+		{ClusterName: ClusterMember3, Weight: 1},
+	}
+
+	take := func(bindingNamespace, bindingName string) []workv1alpha2.TargetCluster {
+		disp := newDispenser(1, nil)
+		disp.bindingNamespace, disp.bindingName = bindingNamespace, bindingName
+		disp.takeByWeight(append(utilhelper.ClusterWeightInfoList{}, weightList...))
+		return disp.result
+	}
+
+	first := take("test", "binding-a")
+	again := take("test", "binding-a")
+	if !helper.IsScheduleResultEqual(first, again) {
+		t.Errorf("expected the same binding to land on the same cluster across reschedules, got %v and %v", first, again)
+	}
+
+	other := take("test", "binding-b")
+	if helper.IsScheduleResultEqual(first, other) {
+		t.Logf("binding-a and binding-b happened to hash to the same cluster: %v", first)
+	}
+}
+
+func Test_stickyDivideReplicas(t *testing.T) {
+	const bindingNamespace, bindingName = "test", "sticky-binding"
+
+	tests := []struct {
+		name  string
+		state *assignState
+		// want is the exact expected result. For the tie-broken "which cluster absorbs the extra
+		// replica" case it's computed from the same clusterBindingHash ordering stickyDivideReplicas
+		// itself uses, so the assertion is an exact match rather than a loose lower bound.
+		want map[string]int32
+	}{
+		{
+			// Scaling 6 -> 7 replicas over 3 equally-weighted clusters must add the extra replica to
+			// exactly one cluster and leave the other 6 replicas exactly where they were.
+			name: "scale up 6 to 7 replicas keeps prior placement",
+			state: &assignState{
+				bindingNamespace: bindingNamespace,
+				bindingName:      bindingName,
+				availableClusters: TargetClustersList{
+					{Name: ClusterMember1, Replicas: 1},
+					{Name: ClusterMember2, Replicas: 1},
+					{Name: ClusterMember3, Replicas: 1},
+				},
+				scheduledClusters: []workv1alpha2.TargetCluster{
+					{Name: ClusterMember1, Replicas: 2},
+					{Name: ClusterMember2, Replicas: 2},
+					{Name: ClusterMember3, Replicas: 2},
+				},
+				targetReplicas: 7,
+			},
+			want: exactlyOneExtra(
+				map[string]int32{ClusterMember1: 2, ClusterMember2: 2, ClusterMember3: 2},
+				bindingNamespace, bindingName,
+			),
+		},
+		{
+			// Removing member3 from the available clusters must redistribute exactly the 2 replicas
+			// that were scheduled on it between member1 and member2, one each, since they're equally
+			// weighted; neither may end up below its prior placement of 2.
+			name: "removing a cluster only reshuffles its replicas",
+			state: &assignState{
+				bindingNamespace: bindingNamespace,
+				bindingName:      bindingName,
+				availableClusters: TargetClustersList{
+					{Name: ClusterMember1, Replicas: 1},
+					{Name: ClusterMember2, Replicas: 1},
+				},
+				scheduledClusters: []workv1alpha2.TargetCluster{
+					{Name: ClusterMember1, Replicas: 2},
+					{Name: ClusterMember2, Replicas: 2},
+					{Name: ClusterMember3, Replicas: 2},
+				},
+				targetReplicas: 6,
+			},
+			want: map[string]int32{ClusterMember1: 3, ClusterMember2: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stickyDivideReplicas(tt.state)
+			if err != nil {
+				t.Fatalf("stickyDivideReplicas() error = %v", err)
+			}
+			byName := make(map[string]int32, len(got))
+			for _, tc := range got {
+				byName[tc.Name] = tc.Replicas
+			}
+			if len(byName) != len(tt.want) {
+				t.Errorf("stickyDivideReplicas() = %v, want exactly %v", byName, tt.want)
+			}
+			for name, want := range tt.want {
+				if byName[name] != want {
+					t.Errorf("stickyDivideReplicas() cluster %s = %d, want exactly %d (full result %v)", name, byName[name], want, byName)
+				}
+			}
+		})
+	}
+}
+
+// exactlyOneExtra returns a copy of prior with one replica added to whichever cluster
+// stickyDivideReplicas' own tiebreak - ascending clusterBindingHash - would pick first, mirroring the
+// deterministic choice made when every cluster is equally deficient.
+func exactlyOneExtra(prior map[string]int32, bindingNamespace, bindingName string) map[string]int32 {
+	names := make([]string, 0, len(prior))
+	for name := range prior {
+		names = append(names, name)
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return clusterBindingHash(names[i], bindingNamespace, bindingName) < clusterBindingHash(names[j], bindingNamespace, bindingName)
+	})
+
+	want := make(map[string]int32, len(prior))
+	for name, replicas := range prior {
+		want[name] = replicas
+	}
+	want[names[0]]++
+	return want
+}
+
+// Test_clampToAvailable covers the case a plain weighted dispense can't guard against on its own: a
+// cluster dispensed more replicas than it can actually host (e.g. by DRF weighting, which is
+// deliberately decoupled from raw capacity) must be capped, with the overflow handed to whichever
+// other cluster still has headroom.
+func Test_clampToAvailable(t *testing.T) {
+	result := []workv1alpha2.TargetCluster{
+		{Name: ClusterMember1, Replicas: 5},
+		{Name: ClusterMember2, Replicas: 1},
+	}
+	availableClusters := []workv1alpha2.TargetCluster{
+		{Name: ClusterMember1, Replicas: 2},
+		{Name: ClusterMember2, Replicas: 10},
+	}
+
+	got := clampToAvailable(result, availableClusters, nil, "test", "binding")
+
+	byName := make(map[string]int32, len(got))
+	var sum int32
+	for _, tc := range got {
+		byName[tc.Name] = tc.Replicas
+		sum += tc.Replicas
+	}
+	if byName[ClusterMember1] > 2 {
+		t.Errorf("expected member1 to be capped at its available 2 replicas, got %d", byName[ClusterMember1])
+	}
+	if sum != 6 {
+		t.Errorf("expected the overflow to be reassigned rather than dropped, got total %d want 6", sum)
+	}
+}
+
+// Test_clampToAvailable_withPriorPlacement covers a scale-up of an already-scheduled binding, where
+// availableClusters only holds each cluster's *additional* headroom on top of what's already running
+// there. A cluster's real cap is scheduled+available, not available alone - capping at available alone
+// would strip away replicas the cluster is already hosting.
+func Test_clampToAvailable_withPriorPlacement(t *testing.T) {
+	scheduledClusters := []workv1alpha2.TargetCluster{
+		{Name: ClusterMember1, Replicas: 3},
+		{Name: ClusterMember2, Replicas: 1},
+	}
+	availableClusters := []workv1alpha2.TargetCluster{
+		{Name: ClusterMember1, Replicas: 2},
+		{Name: ClusterMember2, Replicas: 10},
+	}
+	// member1's real cap is 3 (scheduled) + 2 (available) = 5, but the DRF-weighted dispense handed
+	// it 6, one more than it can host.
+	result := []workv1alpha2.TargetCluster{
+		{Name: ClusterMember1, Replicas: 6},
+		{Name: ClusterMember2, Replicas: 1},
+	}
+
+	got := clampToAvailable(result, availableClusters, scheduledClusters, "test", "binding")
+
+	byName := make(map[string]int32, len(got))
+	var sum int32
+	for _, tc := range got {
+		byName[tc.Name] = tc.Replicas
+		sum += tc.Replicas
+	}
+	if byName[ClusterMember1] != 5 {
+		t.Errorf("expected member1 to be capped at scheduled+available = 5, got %d", byName[ClusterMember1])
+	}
+	if sum != 7 {
+		t.Errorf("expected the overflow to be reassigned rather than dropped, got total %d want 7", sum)
+	}
+}
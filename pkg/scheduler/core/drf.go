@@ -0,0 +1,70 @@
+package core
+
+import (
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+// drfWeightScale rescales the (0, 1] fair-share factor into an integer weight with enough precision
+// for the dispenser's largest-remainder apportionment to tell clusters apart; ClusterWeightInfo.Weight
+// is an int64, so a plain float would be truncated to the point of uselessness.
+const drfWeightScale = 1000
+
+// getDRFWeightInfoList computes a Dominant Resource Fairness weight for every candidate cluster that
+// still has room for at least one replica. For each cluster we take the maximum, across the resources
+// the binding actually requests, of used/capacity - that's the cluster's dominant share, the resource
+// it is closest to exhausting. The weight favors clusters with the smallest dominant share, scaled by
+// how many replicas they can still fit, so a cluster that's CPU-rich but memory-poor isn't favored for
+// a memory-heavy workload just because it looks good on CPU.
+func getDRFWeightInfoList(clusters []*clusterv1alpha1.Cluster, availableClusters []workv1alpha2.TargetCluster, requirements *workv1alpha2.ReplicaRequirements) helper.ClusterWeightInfoList {
+	availableByName := make(map[string]int32, len(availableClusters))
+	for _, tc := range availableClusters {
+		availableByName[tc.Name] = tc.Replicas
+	}
+
+	list := make(helper.ClusterWeightInfoList, 0, len(clusters))
+	for _, cluster := range clusters {
+		available, ok := availableByName[cluster.Name]
+		if !ok || available <= 0 {
+			continue
+		}
+
+		fairShare := 1 - dominantResourceShare(cluster, requirements)
+		weight := int64(fairShare * float64(available) * drfWeightScale)
+		if weight <= 0 {
+			// A tiny fair share can still round down to zero for a cluster that genuinely has
+			// room; keep it in the running with the smallest possible weight rather than dropping
+			// it outright, so clampToAvailable (division_algorithm.go) still has somewhere to put
+			// replicas that don't fit anywhere else.
+			weight = 1
+		}
+		list = append(list, helper.ClusterWeightInfo{
+			ClusterName: cluster.Name,
+			Weight:      weight,
+		})
+	}
+	return list
+}
+
+// dominantResourceShare returns the highest used/capacity ratio, across the resources requested by
+// requirements, that cluster reports in its ResourceSummary. Resources the cluster doesn't report
+// capacity for are skipped rather than treated as fully available or fully exhausted.
+func dominantResourceShare(cluster *clusterv1alpha1.Cluster, requirements *workv1alpha2.ReplicaRequirements) float64 {
+	if requirements == nil || cluster.Status.ResourceSummary == nil {
+		return 0
+	}
+
+	var dominant float64
+	for name := range requirements.ResourceRequest {
+		capacity, ok := cluster.Status.ResourceSummary.Allocatable[name]
+		if !ok || capacity.IsZero() {
+			continue
+		}
+		used := cluster.Status.ResourceSummary.Allocated[name]
+		if share := used.AsApproximateFloat64() / capacity.AsApproximateFloat64(); share > dominant {
+			dominant = share
+		}
+	}
+	return dominant
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func resourceSummary(allocatableCPU, allocatableMem, allocatedCPU, allocatedMem string) *clusterv1alpha1.ResourceSummary {
+	return &clusterv1alpha1.ResourceSummary{
+		Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(allocatableCPU),
+			corev1.ResourceMemory: resource.MustParse(allocatableMem),
+		},
+		Allocated: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(allocatedCPU),
+			corev1.ResourceMemory: resource.MustParse(allocatedMem),
+		},
+	}
+}
+
+func Test_getDRFWeightInfoList(t *testing.T) {
+	// member1 is CPU-rich but has little memory headroom left (90% used); member2 is the opposite,
+	// with CPU nearly exhausted but most of its memory free.
+	clusters := []*clusterv1alpha1.Cluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterMember1},
+			Status:     clusterv1alpha1.ClusterStatus{ResourceSummary: resourceSummary("100", "100Gi", "10", "90Gi")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterMember2},
+			Status:     clusterv1alpha1.ClusterStatus{ResourceSummary: resourceSummary("100", "100Gi", "90", "10Gi")},
+		},
+	}
+	availableClusters := []workv1alpha2.TargetCluster{
+		{Name: ClusterMember1, Replicas: 10},
+		{Name: ClusterMember2, Replicas: 10},
+	}
+	requirements := &workv1alpha2.ReplicaRequirements{
+		ResourceRequest: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+	}
+
+	list := getDRFWeightInfoList(clusters, availableClusters, requirements)
+
+	weights := make(map[string]int64, len(list))
+	for _, w := range list {
+		weights[w.ClusterName] = w.Weight
+	}
+
+	if weights[ClusterMember1] >= weights[ClusterMember2] {
+		t.Errorf("expected member2 (memory headroom) to outweigh member1 (memory-starved) for a memory-heavy binding, got %v", weights)
+	}
+}
+
+// Test_getDRFWeightInfoList_keepsLowShareClusters asserts that a cluster with real but small
+// availability is still returned, rather than being dropped because its (1-dominantShare)*available
+// score rounds down to zero.
+func Test_getDRFWeightInfoList_keepsLowShareClusters(t *testing.T) {
+	clusters := []*clusterv1alpha1.Cluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterMember1},
+			// 99.99% memory used: fairShare is tiny and, multiplied by a single available replica
+			// and drfWeightScale, floors to zero without the weight-1 floor.
+			Status: clusterv1alpha1.ClusterStatus{ResourceSummary: resourceSummary("100", "10000Gi", "0", "9999Gi")},
+		},
+	}
+	availableClusters := []workv1alpha2.TargetCluster{{Name: ClusterMember1, Replicas: 1}}
+	requirements := &workv1alpha2.ReplicaRequirements{
+		ResourceRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}
+
+	list := getDRFWeightInfoList(clusters, availableClusters, requirements)
+	if len(list) != 1 || list[0].Weight <= 0 {
+		t.Errorf("expected a cluster with non-zero availability to stay in the weight list with a positive weight, got %v", list)
+	}
+}
+
+func Test_dominantResourceShare(t *testing.T) {
+	cluster := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: ClusterMember1},
+		Status:     clusterv1alpha1.ClusterStatus{ResourceSummary: resourceSummary("100", "100Gi", "10", "90Gi")},
+	}
+
+	tests := []struct {
+		name         string
+		requirements *workv1alpha2.ReplicaRequirements
+		want         float64
+	}{
+		{
+			name:         "nil requirements report no dominant share",
+			requirements: nil,
+			want:         0,
+		},
+		{
+			name: "memory is the dominant resource",
+			requirements: &workv1alpha2.ReplicaRequirements{
+				ResourceRequest: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+			want: 0.9,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dominantResourceShare(cluster, tt.requirements); got != tt.want {
+				t.Errorf("dominantResourceShare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
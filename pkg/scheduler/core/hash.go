@@ -0,0 +1,16 @@
+package core
+
+import "hash/fnv"
+
+// clusterBindingHash computes a deterministic hash of a cluster name scoped to a particular
+// ResourceBinding's identity. It is used purely as a tiebreaker when clusters are otherwise equal
+// on every other sorting key (e.g. equal static weight, equal available replicas), so that:
+//   - different workloads spread across the same set of equally-weighted clusters instead of all
+//     of them piling onto whichever cluster happens to sort first, and
+//   - a given workload keeps landing on the same cluster across re-scheduling, since the hash only
+//     depends on the binding's own namespace/name and not on scheduling order.
+func clusterBindingHash(clusterName, bindingNamespace, bindingName string) uint32 {
+	h := fnv.New32()
+	_, _ = h.Write([]byte(clusterName + bindingNamespace + "/" + bindingName))
+	return h.Sum32()
+}
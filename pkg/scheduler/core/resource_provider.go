@@ -0,0 +1,107 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	klog "k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// ClusterResourceProvider contributes an opinion on how many replicas a cluster can still take for a
+// binding's resource requirements. Multiple providers can be registered with
+// RegisterClusterResourceProvider; calAvailableReplicas uses the minimum of their answers as a
+// cluster's final available-replica count, so any provider can clamp the number down without the
+// others needing to know about it.
+type ClusterResourceProvider interface {
+	// AvailableReplicas returns how many more replicas matching requirements the cluster can take.
+	AvailableReplicas(cluster *clusterv1alpha1.Cluster, requirements *workv1alpha2.ReplicaRequirements) (int32, error)
+}
+
+// unconstrainedReplicas is returned by a provider that has no opinion on a cluster's availability
+// (e.g. because the binding carries no ReplicaRequirements to size against). It's deliberately large
+// so such a cluster is never the bottleneck, but small enough that summing it across up to 8191
+// candidate clusters in util.GetSumOfReplicas - an int32 - can't silently wrap into a negative
+// availableReplicas.
+const unconstrainedReplicas int32 = 1 << 18
+
+// clusterResourceProviders is the chain consulted by calAvailableReplicas, in registration order.
+var clusterResourceProviders []ClusterResourceProvider
+
+func init() {
+	RegisterClusterResourceProvider(allocatableResourceProvider{})
+}
+
+// RegisterClusterResourceProvider appends a provider to the chain consulted by calAvailableReplicas.
+// The default allocatable-based provider is always registered first; operators can register
+// additional providers (e.g. one bound to a real-time load signal their fleet already exposes) to
+// further constrain the result without forking the scheduler.
+func RegisterClusterResourceProvider(provider ClusterResourceProvider) {
+	clusterResourceProviders = append(clusterResourceProviders, provider)
+}
+
+// minAvailableReplicas asks every registered ClusterResourceProvider how many replicas it thinks
+// cluster can still take for requirements, and returns the minimum across all of them.
+func minAvailableReplicas(cluster *clusterv1alpha1.Cluster, requirements *workv1alpha2.ReplicaRequirements) int32 {
+	available := int32(math.MaxInt32)
+	for _, provider := range clusterResourceProviders {
+		replicas, err := provider.AvailableReplicas(cluster, requirements)
+		if err != nil {
+			klog.ErrorS(err, "Cluster resource provider failed, treating cluster as unavailable", "cluster", cluster.Name)
+			return 0
+		}
+		if replicas < available {
+			available = replicas
+		}
+	}
+	return available
+}
+
+// allocatableResourceProvider is the default ClusterResourceProvider. It estimates available
+// replicas from the cluster's allocatable/allocated ResourceSummary, the same estimate
+// calAvailableReplicas always used before providers became pluggable.
+type allocatableResourceProvider struct{}
+
+func (allocatableResourceProvider) AvailableReplicas(cluster *clusterv1alpha1.Cluster, requirements *workv1alpha2.ReplicaRequirements) (int32, error) {
+	if requirements == nil {
+		return unconstrainedReplicas, nil
+	}
+	return util.MaxAvailableReplicas(cluster, requirements), nil
+}
+
+// ReclaimableResourcePressureProvider reduces a cluster's reported availability according to a
+// real-time reclaimable-resource fraction that some in-cluster agent reports back onto the Cluster
+// object's annotations - e.g. the share of allocatable CPU/memory that is actually free right now, as
+// opposed to merely unallocated on paper. This mirrors how KubeAdmiral folds Katalyst-reported
+// reclaimable resources into its scheduling decisions. Clusters without the annotation are left
+// unconstrained, so registering this provider is a no-op until a fleet starts populating it.
+type ReclaimableResourcePressureProvider struct {
+	// PressureAnnotation is the key a cluster-local agent writes the observed reclaimable fraction
+	// (a string-encoded float in [0, 1]) to on the Cluster object.
+	PressureAnnotation string
+}
+
+// AvailableReplicas scales the allocatable-based estimate down by the reported reclaimable fraction.
+func (p ReclaimableResourcePressureProvider) AvailableReplicas(cluster *clusterv1alpha1.Cluster, requirements *workv1alpha2.ReplicaRequirements) (int32, error) {
+	raw, ok := cluster.Annotations[p.PressureAnnotation]
+	if !ok || requirements == nil {
+		return unconstrainedReplicas, nil
+	}
+
+	reclaimable, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid reclaimable fraction in annotation %q on cluster %s: %v", p.PressureAnnotation, cluster.Name, err)
+	}
+	if reclaimable < 0 {
+		reclaimable = 0
+	} else if reclaimable > 1 {
+		reclaimable = 1
+	}
+
+	baseline := util.MaxAvailableReplicas(cluster, requirements)
+	return int32(float64(baseline) * reclaimable), nil
+}
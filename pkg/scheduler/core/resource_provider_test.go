@@ -0,0 +1,132 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+var errBoom = errors.New("boom")
+
+type fixedResourceProvider struct {
+	replicas int32
+	err      error
+}
+
+func (p fixedResourceProvider) AvailableReplicas(*clusterv1alpha1.Cluster, *workv1alpha2.ReplicaRequirements) (int32, error) {
+	return p.replicas, p.err
+}
+
+func Test_minAvailableReplicas(t *testing.T) {
+	cluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: ClusterMember1}}
+
+	tests := []struct {
+		name      string
+		providers []ClusterResourceProvider
+		want      int32
+	}{
+		{
+			name:      "no providers registered leaves the cluster unconstrained",
+			providers: nil,
+			want:      math.MaxInt32,
+		},
+		{
+			name:      "takes the minimum across providers",
+			providers: []ClusterResourceProvider{fixedResourceProvider{replicas: 10}, fixedResourceProvider{replicas: 3}},
+			want:      3,
+		},
+		{
+			name:      "a failing provider is treated as unavailable",
+			providers: []ClusterResourceProvider{fixedResourceProvider{replicas: 10}, fixedResourceProvider{err: errBoom}},
+			want:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := clusterResourceProviders
+			clusterResourceProviders = tt.providers
+			defer func() { clusterResourceProviders = old }()
+
+			if got := minAvailableReplicas(cluster, nil); got != tt.want {
+				t.Errorf("minAvailableReplicas() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_allocatableResourceProvider_nilRequirementsDontOverflow guards against a regression where nil
+// ReplicaRequirements made AvailableReplicas return math.MaxInt32 per cluster; summing that sentinel
+// across even 2 clusters in an int32 wraps negative, which made dynamicDivideReplicas's
+// availableReplicas < targetReplicas precheck reject scheduling outright.
+func Test_allocatableResourceProvider_nilRequirementsDontOverflow(t *testing.T) {
+	provider := allocatableResourceProvider{}
+	cluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: ClusterMember1}}
+
+	replicas, err := provider.AvailableReplicas(cluster, nil)
+	if err != nil {
+		t.Fatalf("AvailableReplicas() error = %v", err)
+	}
+
+	const clusterCount = 4096
+	var sum int32
+	for i := 0; i < clusterCount; i++ {
+		sum += replicas
+	}
+	if sum <= 0 {
+		t.Errorf("summing unconstrained availability across %d clusters overflowed to %d", clusterCount, sum)
+	}
+}
+
+func Test_ReclaimableResourcePressureProvider(t *testing.T) {
+	provider := ReclaimableResourcePressureProvider{PressureAnnotation: "pressure.karmada.io/reclaimable"}
+	requirements := &workv1alpha2.ReplicaRequirements{
+		ResourceRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+	}
+
+	t.Run("no annotation leaves the cluster unconstrained", func(t *testing.T) {
+		cluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: ClusterMember1}}
+		got, err := provider.AvailableReplicas(cluster, requirements)
+		if err != nil {
+			t.Fatalf("AvailableReplicas() error = %v", err)
+		}
+		if got != unconstrainedReplicas {
+			t.Errorf("AvailableReplicas() = %d, want unconstrained (%d)", got, unconstrainedReplicas)
+		}
+	})
+
+	t.Run("scales the baseline down by the reported reclaimable fraction", func(t *testing.T) {
+		cluster := &clusterv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        ClusterMember1,
+				Annotations: map[string]string{"pressure.karmada.io/reclaimable": "0.5"},
+			},
+			Status: clusterv1alpha1.ClusterStatus{ResourceSummary: resourceSummary("100", "100Gi", "0", "0")},
+		}
+		got, err := provider.AvailableReplicas(cluster, requirements)
+		if err != nil {
+			t.Fatalf("AvailableReplicas() error = %v", err)
+		}
+		if got <= 0 {
+			t.Errorf("AvailableReplicas() = %d, want a reduced but positive value", got)
+		}
+	})
+
+	t.Run("rejects a malformed annotation", func(t *testing.T) {
+		cluster := &clusterv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        ClusterMember1,
+				Annotations: map[string]string{"pressure.karmada.io/reclaimable": "not-a-float"},
+			},
+		}
+		if _, err := provider.AvailableReplicas(cluster, requirements); err == nil {
+			t.Error("AvailableReplicas() expected an error for a malformed annotation, got nil")
+		}
+	})
+}